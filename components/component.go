@@ -0,0 +1,90 @@
+// Package components provides the shared building blocks every DataScienceCluster component
+// embeds: the common managementState/devFlags fields and the reconciliation contract components
+// must implement.
+// +groupName=datasciencecluster.opendatahub.io
+package components
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+// Component holds the fields common to every DataScienceCluster component.
+// +kubebuilder:object:generate=true
+type Component struct {
+	// ManagementState indicates whether and how the operator should manage this component.
+	// +optional
+	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
+
+	// DevFlags holds developer fields for development purposes only.
+	// +optional
+	DevFlags *DevFlags `json:"devFlags,omitempty"`
+}
+
+// DevFlags holds developer-only fields that let a component's defaults be overridden without
+// forking its manifests, for local development and targeted testing.
+// +kubebuilder:object:generate=true
+type DevFlags struct {
+	// Manifests overrides the component's default manifests source.
+	// +optional
+	Manifests []ManifestsConfig `json:"manifests,omitempty"`
+
+	// ImageOverrides lets a developer pin individual component images (e.g. to test a new
+	// IMAGES_ARGO_EXEC build) without forking manifests via Manifests. Keys are the Kustomize
+	// image param names a component's Init registers (e.g. "IMAGES_ARGO_EXEC"); values are
+	// interpreted as the name of an existing env var when one is set, and otherwise as a literal
+	// image reference.
+	// +optional
+	ImageOverrides map[string]string `json:"imageOverrides,omitempty"`
+}
+
+// ManifestsConfig points at an alternate source for a component's manifests.
+// +kubebuilder:object:generate=true
+type ManifestsConfig struct {
+	// URI is the URI of the manifests repository/archive to download.
+	// +optional
+	URI string `json:"uri,omitempty"`
+
+	// ContextDir is the directory, within URI, holding the component's manifests.
+	// +optional
+	ContextDir string `json:"contextDir,omitempty"`
+
+	// SourcePath is the Kustomize path, relative to ContextDir, to build from.
+	// +optional
+	SourcePath string `json:"sourcePath,omitempty"`
+}
+
+// GetManagementState returns the component's configured ManagementState.
+func (c *Component) GetManagementState() operatorv1.ManagementState {
+	return c.ManagementState
+}
+
+// UpdatePrometheusConfig enables or disables this component's rules in the cluster's shared
+// monitoring stack.
+func (c *Component) UpdatePrometheusConfig(cli client.Client, l logr.Logger, enabled bool, component string) error {
+	return cluster.UpdatePrometheusConfig(cli, l, enabled, component)
+}
+
+// ComponentInterface is the contract every DataScienceCluster component implements so the DSC
+// controller can drive it generically.
+type ComponentInterface interface {
+	Init(ctx context.Context, platform cluster.Platform) error
+	OverrideManifests(ctx context.Context, platform cluster.Platform) error
+	GetComponentName() string
+	ReconcileComponent(ctx context.Context,
+		cli client.Client,
+		owner metav1.Object,
+		dscispec *dsciv1.DSCInitializationSpec,
+		conditions *[]conditionsv1.Condition,
+		platform cluster.Platform,
+		currentComponentExists bool,
+	) error
+}
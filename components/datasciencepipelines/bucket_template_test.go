@@ -0,0 +1,34 @@
+package datasciencepipelines
+
+import "testing"
+
+func TestRenderBucketTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  bool
+		want     string
+	}{
+		{name: "empty template returns empty bucket", template: "", want: ""},
+		{name: "namespace substitution", template: "{{.Namespace}}-pipelines", want: "team-a-pipelines"},
+		{name: "invalid template", template: "{{.Namespace", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := renderBucketTemplate(tt.template, "team-a")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error for template %q, got none", tt.template)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("renderBucketTemplate(%q) returned error: %v", tt.template, err)
+			}
+			if got != tt.want {
+				t.Errorf("renderBucketTemplate(%q) = %q, want %q", tt.template, got, tt.want)
+			}
+		})
+	}
+}
@@ -5,8 +5,13 @@ package datasciencepipelines
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
@@ -14,7 +19,11 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
@@ -30,6 +39,65 @@ var (
 	Path            = deploy.DefaultManifestPath + "/" + ComponentName + "/base"
 	OverlayPath     = deploy.DefaultManifestPath + "/" + ComponentName + "/overlays"
 	ArgoWorkflowCRD = "workflows.argoproj.io"
+
+	// WorkflowControllerConfigMapName is the ConfigMap consumed by the DSPO overlay to
+	// configure the Argo workflow-controller (mounted as workflow-controller-configmap).
+	WorkflowControllerConfigMapName = "workflow-controller-configmap"
+
+	// ExternalArgoConfigMapName is the ConfigMap consumed by the DSPO overlay to tell DSPO
+	// whether it should deploy its own Argo Workflows controller or reuse the one already
+	// present on the cluster.
+	ExternalArgoConfigMapName = "dspo-external-argo-config"
+
+	// WorkflowControllerOverridesConfigMapName is the ConfigMap consumed by DSPO to tune the
+	// workflow-controller Deployment itself (WorkflowController.Deploy/Resources/NodeSelector/
+	// Tolerations). It is kept separate from WorkflowControllerConfigMapName because that one is
+	// Argo's own runtime config with a schema Argo defines; deployment-level knobs don't belong
+	// in it.
+	WorkflowControllerOverridesConfigMapName = "dspo-workflow-controller-overrides"
+
+	// AutoProvisionedDSPAName is the name given to every DataSciencePipelinesApplication CR
+	// created by AutoProvision.
+	AutoProvisionedDSPAName = "auto-provisioned"
+
+	// DefaultDatabaseSecretName is the name given to the per-namespace Secret that mirrors
+	// Defaults.Database's credentials into every auto-provisioned DSPA's own namespace.
+	DefaultDatabaseSecretName = "dsp-default-database"
+
+	// DefaultObjectStorageSecretName is the name given to the per-namespace Secret that mirrors
+	// Defaults.ObjectStorage's credentials into every auto-provisioned DSPA's own namespace.
+	DefaultObjectStorageSecretName = "dsp-default-objectstorage"
+)
+
+// AutoProvisionedLabel marks a DataSciencePipelinesApplication CR as owned by this component's
+// AutoProvision reconciliation, so it can be told apart from hand-created DSPAs during cleanup.
+const AutoProvisionedLabel = "datasciencepipelines.opendatahub.io/auto-provisioned"
+
+// ReasonUsingExternalArgo is set on the DSPv2Argo capability condition when
+// ArgoWorkflowsControllerState is Unmanaged, informing the user that the operator deferred
+// to a pre-existing, externally managed Argo Workflows installation.
+const ReasonUsingExternalArgo = "UsingExternalArgo"
+
+// ArgoWorkflowsControllerManagementState controls how DataSciencePipelines treats the cluster's
+// Argo Workflows installation.
+type ArgoWorkflowsControllerManagementState string
+
+const (
+	// ArgoWorkflowsControllerManaged is the default: ODH owns the workflows.argoproj.io CRD and
+	// fails reconciliation if a foreign, unlabeled installation is found.
+	ArgoWorkflowsControllerManaged ArgoWorkflowsControllerManagementState = "Managed"
+	// ArgoWorkflowsControllerRemoved tells DSPO, via the ExternalArgoConfigMapName flag, not to
+	// render the Argo workflow-controller sub-manifests from its own overlay, leaving DSPA
+	// pipelines without a workflow engine of their own. DSPO (not this operator) owns those
+	// manifests, so the skip happens on the DSPO side when it reads the flag; this operator's
+	// own DeployManifestsFromPath call is unaffected either way. Like Unmanaged, it also skips
+	// the CRD ownership check, since a user picking Removed to run their own external Argo
+	// shouldn't be blocked by a foreign workflows.argoproj.io CRD either.
+	ArgoWorkflowsControllerRemoved ArgoWorkflowsControllerManagementState = "Removed"
+	// ArgoWorkflowsControllerUnmanaged skips the CRD ownership check and the workflow-controller
+	// sub-manifests, and tells DSPO to reuse whatever Argo Workflows installation already exists
+	// on the cluster.
+	ArgoWorkflowsControllerUnmanaged ArgoWorkflowsControllerManagementState = "Unmanaged"
 )
 
 // Verifies that Dashboard implements ComponentInterface.
@@ -39,6 +107,151 @@ var _ components.ComponentInterface = (*DataSciencePipelines)(nil)
 // +kubebuilder:object:generate=true
 type DataSciencePipelines struct {
 	components.Component `json:""`
+
+	// WorkflowController allows tuning the Argo Workflow controller deployed by the
+	// Data Science Pipelines Operator (DSPO). When unset, DSPO's own defaults apply.
+	// +optional
+	WorkflowController *WorkflowController `json:"workflowController,omitempty"`
+
+	// ArgoWorkflowsControllerState controls how this component treats a pre-existing Argo
+	// Workflows installation on the cluster. Defaults to Managed.
+	// +kubebuilder:validation:Enum=Managed;Removed;Unmanaged
+	// +kubebuilder:default=Managed
+	// +optional
+	ArgoWorkflowsControllerState ArgoWorkflowsControllerManagementState `json:"argoWorkflowsControllerState,omitempty"`
+
+	// AutoProvision, when set, creates and keeps in sync a DataSciencePipelinesApplication CR
+	// in every namespace matching NamespaceSelector, using Template as the desired spec. This
+	// removes the need to hand-create a DSPA in every tenant project of a multi-tenant cluster.
+	// +optional
+	AutoProvision *AutoProvision `json:"autoProvision,omitempty"`
+
+	// Defaults holds cluster-wide default external database and object storage connection
+	// settings. Only AutoProvision consumes these today: AutoProvisionedDSPAName CRs are
+	// given these defaults (and a per-namespace mirror of their credentials) unless their
+	// own Template already configures a database/objectStorage. Setting Defaults without
+	// AutoProvision is rejected, since it would otherwise silently have no effect.
+	// +optional
+	Defaults *Defaults `json:"defaults,omitempty"`
+}
+
+// Defaults holds cluster-wide default external storage settings for DataSciencePipelines.
+// +kubebuilder:object:generate=true
+type Defaults struct {
+	// Database points DSPAs at an external MySQL/PostgreSQL database for pipeline metadata
+	// instead of each provisioning its own in-cluster database.
+	// +optional
+	Database *DatabaseDefaults `json:"database,omitempty"`
+
+	// ObjectStorage points DSPAs at an external S3-compatible bucket for pipeline artifacts
+	// instead of each provisioning its own in-cluster MinIO instance.
+	// +optional
+	ObjectStorage *ObjectStorageDefaults `json:"objectStorage,omitempty"`
+}
+
+// DatabaseDefaults configures the default external database connection shared by DSPAs.
+// +kubebuilder:object:generate=true
+type DatabaseDefaults struct {
+	// Host is the external database hostname.
+	Host string `json:"host"`
+
+	// Port is the external database port.
+	Port string `json:"port"`
+
+	// Username used to authenticate to the external database.
+	Username string `json:"username"`
+
+	// DBName is the default database/schema name used for pipeline metadata.
+	DBName string `json:"dbName"`
+
+	// CredentialsSecret references the key in a Secret, in the ApplicationsNamespace, that
+	// holds the database password.
+	CredentialsSecret corev1.SecretKeySelector `json:"credentialsSecret"`
+}
+
+// ObjectStorageDefaults configures the default external object storage connection shared by
+// DSPAs.
+// +kubebuilder:object:generate=true
+type ObjectStorageDefaults struct {
+	// Endpoint is the S3-compatible endpoint URL.
+	Endpoint string `json:"endpoint"`
+
+	// Region is the object storage region.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// CredentialsSecret references the key in a Secret, in the ApplicationsNamespace, that
+	// holds the access/secret key pair (as a single blob, e.g. an AWS credentials file).
+	CredentialsSecret corev1.SecretKeySelector `json:"credentialsSecret"`
+
+	// BucketTemplate is a Go text/template string, evaluated per-namespace with "Namespace" in
+	// scope, used to derive the default bucket name, e.g. "{{.Namespace}}-pipelines".
+	BucketTemplate string `json:"bucketTemplate"`
+}
+
+// AutoProvision configures namespace-scoped auto-provisioning of DataSciencePipelinesApplication
+// CRs from the DSC.
+// +kubebuilder:object:generate=true
+type AutoProvision struct {
+	// NamespaceSelector selects the namespaces that should receive an auto-provisioned DSPA.
+	// Required: an empty/unset selector would otherwise match every namespace on the cluster,
+	// including kube-system, default, and openshift-*, so it must be set explicitly.
+	// +kubebuilder:validation:Required
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector"`
+
+	// Template is the DataSciencePipelinesApplication spec, as raw JSON, applied to the DSPA
+	// created in every matching namespace. It is kept as RawExtension, rather than a typed
+	// DSPASpec, so this package doesn't need to vendor the DSPO API group; it is applied to an
+	// unstructured DSPA object at the dspaGroupVersionKind.
+	Template runtime.RawExtension `json:"template"`
+}
+
+// dspaGroupVersionKind identifies the DataSciencePipelinesApplication CRD that AutoProvision
+// creates, without this package depending on the DSPO API module.
+var dspaGroupVersionKind = schema.GroupVersionKind{
+	Group:   "datasciencepipelinesapplications.opendatahub.io",
+	Version: "v1alpha1",
+	Kind:    "DataSciencePipelinesApplication",
+}
+
+// getArgoWorkflowsControllerState returns the configured ArgoWorkflowsControllerState,
+// defaulting to Managed when unset.
+func (d *DataSciencePipelines) getArgoWorkflowsControllerState() ArgoWorkflowsControllerManagementState {
+	if d.ArgoWorkflowsControllerState == "" {
+		return ArgoWorkflowsControllerManaged
+	}
+	return d.ArgoWorkflowsControllerState
+}
+
+// WorkflowController mirrors the subset of the DSPO CR's WorkflowController shape that
+// is useful to expose at the DSC level, so cluster admins can tune Argo behavior without
+// forking manifests.
+// +kubebuilder:object:generate=true
+type WorkflowController struct {
+	// Deploy indicates whether DSPO should deploy the workflow-controller. Defaults to true.
+	// +optional
+	Deploy *bool `json:"deploy,omitempty"`
+
+	// Image overrides the default workflow-controller image.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// CustomConfig holds overrides merged into the workflow-controller-configmap consumed
+	// by the DSPO overlay, e.g. "containerRuntimeExecutor" or "parallelism".
+	// +optional
+	CustomConfig map[string]string `json:"customConfig,omitempty"`
+
+	// Resources specifies compute resources for the workflow-controller container.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// NodeSelector constrains the workflow-controller pod to nodes with matching labels.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations allows the workflow-controller pod to schedule onto tainted nodes.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
 }
 
 func (d *DataSciencePipelines) Init(ctx context.Context, _ cluster.Platform) error {
@@ -60,6 +273,63 @@ func (d *DataSciencePipelines) Init(ctx context.Context, _ cluster.Platform) err
 		log.Error(err, "failed to update image", "path", Path)
 	}
 
+	// DevFlags.ImageOverrides lets a developer pin a single component image (e.g. to test a new
+	// IMAGES_ARGO_EXEC build) without forking manifests via DevFlags.Manifests. Applied after the
+	// RELATED_IMAGE_* substitution above so an override always wins.
+	if d.DevFlags != nil {
+		for param, override := range d.DevFlags.ImageOverrides {
+			if err := applyImageOverride(Path, param, override); err != nil {
+				log.Error(err, "failed to apply image override", "param", param)
+			}
+		}
+	}
+
+	return nil
+}
+
+// applyImageOverride sets param's image in componentPath to override. When override names an
+// existing env var it is resolved the same way as every entry in Init's imageParamMap, via
+// deploy.ApplyParams' env-var indirection; otherwise override is itself a literal image
+// reference, written directly into the params file so it doesn't need a synthetic env var to
+// round-trip through deploy.ApplyParams' env-var-only substitution.
+func applyImageOverride(componentPath, param, override string) error {
+	if _, ok := os.LookupEnv(override); ok {
+		return deploy.ApplyParams(componentPath, map[string]string{param: override})
+	}
+	return applyLiteralParam(componentPath, param, override)
+}
+
+// applyLiteralParam writes param=value verbatim into componentPath's params.env file, overwriting
+// any existing entry for param (or appending one).
+func applyLiteralParam(componentPath, param, value string) error {
+	paramsPath := filepath.Join(componentPath, "params.env")
+
+	data, err := os.ReadFile(paramsPath)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", paramsPath, err)
+	}
+
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	if len(lines) == 1 && lines[0] == "" {
+		lines = nil
+	}
+
+	found := false
+	for i, line := range lines {
+		if key, _, ok := strings.Cut(line, "="); ok && key == param {
+			lines[i] = param + "=" + value
+			found = true
+			break
+		}
+	}
+	if !found {
+		lines = append(lines, param+"="+value)
+	}
+
+	if err := os.WriteFile(paramsPath, []byte(strings.Join(lines, "\n")+"\n"), 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", paramsPath, err)
+	}
+
 	return nil
 }
 
@@ -89,12 +359,14 @@ func (d *DataSciencePipelines) ReconcileComponent(ctx context.Context,
 	cli client.Client,
 	owner metav1.Object,
 	dscispec *dsciv1.DSCInitializationSpec,
+	conditions *[]conditionsv1.Condition,
 	platform cluster.Platform,
 	_ bool,
 ) error {
 	l := logf.FromContext(ctx)
 	enabled := d.GetManagementState() == operatorv1.Managed
 	monitoringEnabled := dscispec.Monitoring.ManagementState == operatorv1.Managed
+	argoState := d.getArgoWorkflowsControllerState()
 
 	if enabled {
 		if d.DevFlags != nil {
@@ -104,12 +376,31 @@ func (d *DataSciencePipelines) ReconcileComponent(ctx context.Context,
 			}
 		}
 		// skip check if the dependent operator has beeninstalled, this is done in dashboard
-		// Check for existing Argo Workflows
-		if err := UnmanagedArgoWorkFlowExists(ctx, cli); err != nil {
+		// Check for existing Argo Workflows, unless we were told to adopt/ignore it
+		if err := UnmanagedArgoWorkFlowExists(ctx, cli, argoState); err != nil {
 			return err
 		}
 	}
 
+	if enabled {
+		if err := reconcileExternalArgoConfig(ctx, cli, owner, dscispec, argoState); err != nil {
+			return fmt.Errorf("failed to reconcile external Argo config: %w", err)
+		}
+
+		if argoState == ArgoWorkflowsControllerUnmanaged {
+			SetExistingArgoCondition(conditions, ReasonUsingExternalArgo,
+				fmt.Sprintf("%s CRD already exists and is managed outside this operator; DSPO will reuse it", ArgoWorkflowCRD))
+		}
+	}
+
+	if enabled && d.WorkflowController != nil && d.WorkflowController.Image != "" {
+		// Must run before DeployManifestsFromPath below, so the image override takes effect on
+		// the manifests it renders.
+		if err := applyImageOverride(Path, "IMAGES_ARGO_WORKFLOWCONTROLLER", d.WorkflowController.Image); err != nil {
+			return fmt.Errorf("failed to apply workflow-controller image override: %w", err)
+		}
+	}
+
 	// new overlay
 	manifestsPath := filepath.Join(OverlayPath, "rhoai")
 	if platform == cluster.OpenDataHub || platform == "" {
@@ -125,6 +416,30 @@ func (d *DataSciencePipelines) ReconcileComponent(ctx context.Context,
 		if err := cluster.WaitForDeploymentAvailable(ctx, cli, ComponentName, dscispec.ApplicationsNamespace, 20, 2); err != nil {
 			return fmt.Errorf("deployment for %s is not ready to server: %w", ComponentName, err)
 		}
+
+		if d.WorkflowController != nil {
+			// Must run after DeployManifestsFromPath above: the DSPO/Argo overlay also writes
+			// WorkflowControllerConfigMapName, so merging into it beforehand would just get
+			// clobbered by the overlay apply.
+			if err := d.reconcileWorkflowControllerOverrides(ctx, cli, owner, dscispec); err != nil {
+				return fmt.Errorf("failed to reconcile workflow controller overrides: %w", err)
+			}
+		}
+
+		if d.Defaults != nil && d.AutoProvision == nil {
+			// Defaults are only wired into DSPAs created by AutoProvision below; this operator
+			// doesn't ship a DSPO-side default-injection webhook that would apply them to
+			// hand-created DSPAs too. Fail loudly instead of silently doing nothing, since a
+			// misconfiguration here is easy to miss otherwise.
+			return fmt.Errorf("spec.components.datasciencepipelines.defaults requires autoProvision to also be set: " +
+				"defaults are only consumed by auto-provisioned DSPAs, so without autoProvision they would have no effect")
+		}
+
+		if d.AutoProvision != nil {
+			if err := d.reconcileAutoProvisionedDSPAs(ctx, cli, owner, dscispec); err != nil {
+				return fmt.Errorf("failed to reconcile auto-provisioned DSPAs: %w", err)
+			}
+		}
 	}
 
 	// CloudService Monitoring handling
@@ -144,8 +459,105 @@ func (d *DataSciencePipelines) ReconcileComponent(ctx context.Context,
 	return nil
 }
 
+// reconcileWorkflowControllerOverrides renders the DSC-level WorkflowController tuning into two
+// ConfigMaps:
+//   - WorkflowControllerConfigMapName (CustomConfig only): Argo's own workflow-controller-configmap,
+//     which has a schema Argo itself defines, so only the pass-through keys an admin explicitly
+//     asked for go here, merged into whatever the DSPO/Argo overlay already wrote rather than
+//     replacing Data wholesale.
+//   - WorkflowControllerOverridesConfigMapName (Deploy/Resources/NodeSelector/Tolerations): these
+//     are Deployment-level knobs, not Argo runtime config, so they get their own DSPO-consumed
+//     ConfigMap instead of polluting Argo's.
+func (d *DataSciencePipelines) reconcileWorkflowControllerOverrides(ctx context.Context,
+	cli client.Client,
+	owner metav1.Object,
+	dscispec *dsciv1.DSCInitializationSpec,
+) error {
+	wc := d.WorkflowController
+
+	if len(wc.CustomConfig) != 0 {
+		// workflow-controller-configmap is also written to by the DSPO/Argo overlay itself, so
+		// merge our overrides into whatever is already there instead of replacing Data wholesale.
+		cm := &corev1.ConfigMap{}
+		if err := cli.Get(ctx, client.ObjectKey{Name: WorkflowControllerConfigMapName, Namespace: dscispec.ApplicationsNamespace}, cm); err != nil {
+			if !k8serr.IsNotFound(err) {
+				return fmt.Errorf("failed to get %s: %w", WorkflowControllerConfigMapName, err)
+			}
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      WorkflowControllerConfigMapName,
+					Namespace: dscispec.ApplicationsNamespace,
+				},
+			}
+		}
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		for k, v := range wc.CustomConfig {
+			cm.Data[k] = v
+		}
+		if err := cluster.CreateOrUpdateConfigMap(ctx, cli, cm, owner); err != nil {
+			return fmt.Errorf("failed to create/update %s: %w", WorkflowControllerConfigMapName, err)
+		}
+	}
+
+	overrides := map[string]string{}
+	if wc.Deploy != nil {
+		overrides["deploy"] = strconv.FormatBool(*wc.Deploy)
+	}
+	if wc.Resources != nil {
+		encoded, err := json.Marshal(wc.Resources)
+		if err != nil {
+			return fmt.Errorf("failed to encode workflow-controller resources: %w", err)
+		}
+		overrides["resources"] = string(encoded)
+	}
+	if len(wc.NodeSelector) != 0 {
+		encoded, err := json.Marshal(wc.NodeSelector)
+		if err != nil {
+			return fmt.Errorf("failed to encode workflow-controller nodeSelector: %w", err)
+		}
+		overrides["nodeSelector"] = string(encoded)
+	}
+	if len(wc.Tolerations) != 0 {
+		encoded, err := json.Marshal(wc.Tolerations)
+		if err != nil {
+			return fmt.Errorf("failed to encode workflow-controller tolerations: %w", err)
+		}
+		overrides["tolerations"] = string(encoded)
+	}
+
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	// Unlike WorkflowControllerConfigMapName, nothing else writes to this ConfigMap, so it's safe
+	// to replace Data wholesale.
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      WorkflowControllerOverridesConfigMapName,
+			Namespace: dscispec.ApplicationsNamespace,
+		},
+		Data: overrides,
+	}
+	if err := cluster.CreateOrUpdateConfigMap(ctx, cli, cm, owner); err != nil {
+		return fmt.Errorf("failed to create/update %s: %w", WorkflowControllerOverridesConfigMapName, err)
+	}
+
+	return nil
+}
+
+// UnmanagedArgoWorkFlowExists verifies that an Argo Workflows CRD found on the cluster, if any,
+// is one ODH itself deployed. Only ArgoWorkflowsControllerManaged asserts that ownership, so the
+// check only applies in that state: Removed and Unmanaged both defer to whatever Argo Workflows
+// installation (if any) is already on the cluster, so a foreign CRD is expected, not an error.
 func UnmanagedArgoWorkFlowExists(ctx context.Context,
-	cli client.Client) error {
+	cli client.Client,
+	argoState ArgoWorkflowsControllerManagementState) error {
+	if argoState != ArgoWorkflowsControllerManaged {
+		return nil
+	}
+
 	workflowCRD := &apiextensionsv1.CustomResourceDefinition{}
 	if err := cli.Get(ctx, client.ObjectKey{Name: ArgoWorkflowCRD}, workflowCRD); err != nil {
 		if k8serr.IsNotFound(err) {
@@ -159,10 +571,289 @@ func UnmanagedArgoWorkFlowExists(ctx context.Context,
 		return nil
 	}
 	return fmt.Errorf("%s CRD already exists but not deployed by this operator. "+
-		"Remove existing Argo workflows or set `spec.components.datasciencepipelines.managementState` to Removed to proceed ", ArgoWorkflowCRD)
+		"Remove existing Argo workflows, set `spec.components.datasciencepipelines.managementState` to Removed, "+
+		"or set `spec.components.datasciencepipelines.argoWorkflowsControllerState` to Unmanaged to proceed ", ArgoWorkflowCRD)
+}
+
+// reconcileExternalArgoConfig writes the ConfigMap the DSPO overlay reads to decide whether to
+// deploy its own Argo workflow-controller sub-manifests or defer to an externally managed one.
+func reconcileExternalArgoConfig(ctx context.Context,
+	cli client.Client,
+	owner metav1.Object,
+	dscispec *dsciv1.DSCInitializationSpec,
+	argoState ArgoWorkflowsControllerManagementState,
+) error {
+	deployController := argoState == ArgoWorkflowsControllerManaged
+	useExternalArgo := argoState == ArgoWorkflowsControllerUnmanaged
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ExternalArgoConfigMapName,
+			Namespace: dscispec.ApplicationsNamespace,
+		},
+		Data: map[string]string{
+			"deployWorkflowController": strconv.FormatBool(deployController),
+			"useExternalArgo":          strconv.FormatBool(useExternalArgo),
+		},
+	}
+	if err := cluster.CreateOrUpdateConfigMap(ctx, cli, cm, owner); err != nil {
+		return fmt.Errorf("failed to create/update %s: %w", ExternalArgoConfigMapName, err)
+	}
+
+	return nil
+}
+
+// applyDatabaseDefault injects Defaults.Database into spec's "database" field, unless the DSPA
+// template already configures its own. A DSPA can only reference Secrets in its own namespace, so
+// db.CredentialsSecret (which lives in ApplicationsNamespace) is read once and mirrored into
+// namespace under DefaultDatabaseSecretName — the one place its value is actually consumed,
+// rather than sitting duplicated and unread in ApplicationsNamespace.
+func applyDatabaseDefault(ctx context.Context,
+	cli client.Client,
+	owner metav1.Object,
+	dscispec *dsciv1.DSCInitializationSpec,
+	db *DatabaseDefaults,
+	namespace string,
+	spec map[string]interface{},
+) error {
+	if _, exists := spec["database"]; exists {
+		return nil
+	}
+
+	password, err := resolveSecretKey(ctx, cli, dscispec.ApplicationsNamespace, db.CredentialsSecret)
+	if err != nil {
+		return fmt.Errorf("failed to resolve database credentials secret: %w", err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DefaultDatabaseSecretName,
+			Namespace: namespace,
+		},
+		StringData: map[string]string{
+			"password": password,
+		},
+	}
+	if err := cluster.CreateOrUpdateSecret(ctx, cli, secret, owner); err != nil {
+		return fmt.Errorf("failed to mirror %s into %s: %w", DefaultDatabaseSecretName, namespace, err)
+	}
+
+	spec["database"] = map[string]interface{}{
+		"host":     db.Host,
+		"port":     db.Port,
+		"username": db.Username,
+		"dbName":   db.DBName,
+		"passwordSecret": map[string]interface{}{
+			"name": DefaultDatabaseSecretName,
+			"key":  "password",
+		},
+	}
+
+	return nil
+}
+
+// applyObjectStorageDefault injects Defaults.ObjectStorage into spec's "objectStorage" field,
+// unless the DSPA template already configures its own, mirroring the credentials the same way
+// applyDatabaseDefault does.
+func applyObjectStorageDefault(ctx context.Context,
+	cli client.Client,
+	owner metav1.Object,
+	dscispec *dsciv1.DSCInitializationSpec,
+	objectStorage *ObjectStorageDefaults,
+	namespace string,
+	spec map[string]interface{},
+) error {
+	if _, exists := spec["objectStorage"]; exists {
+		return nil
+	}
+
+	credentials, err := resolveSecretKey(ctx, cli, dscispec.ApplicationsNamespace, objectStorage.CredentialsSecret)
+	if err != nil {
+		return fmt.Errorf("failed to resolve object storage credentials secret: %w", err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DefaultObjectStorageSecretName,
+			Namespace: namespace,
+		},
+		StringData: map[string]string{
+			"credentials": credentials,
+		},
+	}
+	if err := cluster.CreateOrUpdateSecret(ctx, cli, secret, owner); err != nil {
+		return fmt.Errorf("failed to mirror %s into %s: %w", DefaultObjectStorageSecretName, namespace, err)
+	}
+
+	bucket, err := renderBucketTemplate(objectStorage.BucketTemplate, namespace)
+	if err != nil {
+		return fmt.Errorf("invalid bucketTemplate: %w", err)
+	}
+
+	spec["objectStorage"] = map[string]interface{}{
+		"endpoint": objectStorage.Endpoint,
+		"region":   objectStorage.Region,
+		"bucket":   bucket,
+		"credentialsSecret": map[string]interface{}{
+			"name": DefaultObjectStorageSecretName,
+			"key":  "credentials",
+		},
+	}
+
+	return nil
+}
+
+// renderBucketTemplate evaluates a BucketTemplate like "{{.Namespace}}-pipelines" for namespace.
+func renderBucketTemplate(bucketTemplate, namespace string) (string, error) {
+	if bucketTemplate == "" {
+		return "", nil
+	}
+
+	tmpl, err := texttemplate.New("bucketTemplate").Parse(bucketTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	var rendered strings.Builder
+	if err := tmpl.Execute(&rendered, struct{ Namespace string }{Namespace: namespace}); err != nil {
+		return "", err
+	}
+
+	return rendered.String(), nil
+}
+
+// resolveSecretKey fetches a single key out of a Secret in namespace, as referenced by ref.
+func resolveSecretKey(ctx context.Context, cli client.Client, namespace string, ref corev1.SecretKeySelector) (string, error) {
+	secret := &corev1.Secret{}
+	if err := cli.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: namespace}, secret); err != nil {
+		return "", fmt.Errorf("failed to get secret %s/%s: %w", namespace, ref.Name, err)
+	}
+
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("key %q not found in secret %s/%s", ref.Key, namespace, ref.Name)
+	}
+
+	return string(value), nil
+}
+
+// reconcileAutoProvisionedDSPAs creates/updates a DataSciencePipelinesApplication CR, built from
+// AutoProvision.Template, in every namespace matching AutoProvision.NamespaceSelector, and removes
+// previously auto-provisioned DSPAs from namespaces that no longer match.
+//
+// Every auto-provisioned DSPA also gets an OwnerReference pointing at owner (typically the
+// cluster-scoped DSC, which may legally own namespaced dependents) so it is garbage collected if
+// the DSC itself is deleted. That alone can't express "delete because this namespace stopped
+// matching NamespaceSelector" though — Kubernetes GC only fires when the owner is deleted, not
+// when a label changes — so AutoProvisionedLabel plus the explicit sweep below remain the
+// mechanism for selector-drift cleanup.
+func (d *DataSciencePipelines) reconcileAutoProvisionedDSPAs(ctx context.Context, cli client.Client, owner metav1.Object, dscispec *dsciv1.DSCInitializationSpec) error {
+	l := logf.FromContext(ctx)
+	ap := d.AutoProvision
+
+	if ap.NamespaceSelector == nil {
+		return fmt.Errorf("autoProvision.namespaceSelector must be set explicitly; " +
+			"a nil selector would match every namespace on the cluster, including kube-system, default, and openshift-*")
+	}
+	selector, err := metav1.LabelSelectorAsSelector(ap.NamespaceSelector)
+	if err != nil {
+		return fmt.Errorf("invalid namespaceSelector: %w", err)
+	}
+
+	var templateSpec map[string]interface{}
+	if len(ap.Template.Raw) > 0 {
+		if err := json.Unmarshal(ap.Template.Raw, &templateSpec); err != nil {
+			return fmt.Errorf("invalid autoProvision template: %w", err)
+		}
+	}
+
+	namespaces := &corev1.NamespaceList{}
+	if err := cli.List(ctx, namespaces, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return fmt.Errorf("failed to list namespaces: %w", err)
+	}
+
+	matching := map[string]bool{}
+	for i := range namespaces.Items {
+		ns := namespaces.Items[i].Name
+		matching[ns] = true
+
+		dspa := &unstructured.Unstructured{}
+		dspa.SetGroupVersionKind(dspaGroupVersionKind)
+		dspa.SetName(AutoProvisionedDSPAName)
+		dspa.SetNamespace(ns)
+
+		if _, err := controllerutil.CreateOrUpdate(ctx, cli, dspa, func() error {
+			dspaLabels := dspa.GetLabels()
+			if dspaLabels == nil {
+				dspaLabels = map[string]string{}
+			}
+			dspaLabels[AutoProvisionedLabel] = "true"
+			dspa.SetLabels(dspaLabels)
+
+			spec := map[string]interface{}{}
+			if templateSpec != nil {
+				spec = runtime.DeepCopyJSON(templateSpec)
+			}
+
+			if d.Defaults != nil {
+				if d.Defaults.Database != nil {
+					if err := applyDatabaseDefault(ctx, cli, owner, dscispec, d.Defaults.Database, ns, spec); err != nil {
+						return fmt.Errorf("failed to apply database default for namespace %s: %w", ns, err)
+					}
+				}
+				if d.Defaults.ObjectStorage != nil {
+					if err := applyObjectStorageDefault(ctx, cli, owner, dscispec, d.Defaults.ObjectStorage, ns, spec); err != nil {
+						return fmt.Errorf("failed to apply object storage default for namespace %s: %w", ns, err)
+					}
+				}
+			}
+
+			if err := unstructured.SetNestedMap(dspa.Object, spec, "spec"); err != nil {
+				return fmt.Errorf("failed to set spec: %w", err)
+			}
+
+			if ownerObj, ok := owner.(client.Object); ok {
+				if err := controllerutil.SetOwnerReference(ownerObj, dspa, cli.Scheme()); err != nil {
+					return fmt.Errorf("failed to set owner reference: %w", err)
+				}
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("failed to create/update DSPA in namespace %s: %w", ns, err)
+		}
+	}
+
+	existing := &unstructured.UnstructuredList{}
+	existing.SetGroupVersionKind(dspaGroupVersionKind)
+	if err := cli.List(ctx, existing, client.MatchingLabels{AutoProvisionedLabel: "true"}); err != nil {
+		return fmt.Errorf("failed to list auto-provisioned DSPAs: %w", err)
+	}
+	for i := range existing.Items {
+		dspa := &existing.Items[i]
+		if dspa.GetName() == AutoProvisionedDSPAName && matching[dspa.GetNamespace()] {
+			continue
+		}
+		if err := cli.Delete(ctx, dspa); err != nil && !k8serr.IsNotFound(err) {
+			return fmt.Errorf("failed to remove stale auto-provisioned DSPA in namespace %s: %w", dspa.GetNamespace(), err)
+		}
+		l.Info("removed auto-provisioned DSPA from namespace no longer matching namespaceSelector", "namespace", dspa.GetNamespace())
+	}
+
+	return nil
 }
 
+// SetExistingArgoCondition reconciles the DSPv2Argo capability and component conditions to
+// reflect a pre-existing Argo Workflows installation. ReasonUsingExternalArgo is informational
+// (ArgoWorkflowsControllerUnmanaged: the user opted in to reusing the cluster's Argo, so the
+// conditions report ConditionTrue); any other reason reports the hard failure from
+// UnmanagedArgoWorkFlowExists as ConditionFalse, same as before.
 func SetExistingArgoCondition(conditions *[]conditionsv1.Condition, reason, message string) {
+	if reason == ReasonUsingExternalArgo {
+		status.SetCondition(conditions, string(status.CapabilityDSPv2Argo), reason, message, corev1.ConditionTrue)
+		status.SetComponentCondition(conditions, ComponentName, reason, message, corev1.ConditionTrue)
+		return
+	}
 	status.SetCondition(conditions, string(status.CapabilityDSPv2Argo), reason, message, corev1.ConditionFalse)
 	status.SetComponentCondition(conditions, ComponentName, status.ReconcileFailed, message, corev1.ConditionFalse)
 }
@@ -0,0 +1,120 @@
+package datasciencepipelines
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+)
+
+func newDSPATestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to register corev1 types: %v", err)
+	}
+	scheme.AddKnownTypeWithName(dspaGroupVersionKind, &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(dspaGroupVersionKind.GroupVersion().WithKind(dspaGroupVersionKind.Kind+"List"), &unstructured.UnstructuredList{})
+
+	return scheme
+}
+
+func testNamespace(name string, labels map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func getDSPA(t *testing.T, cli client.Client, namespace string) (*unstructured.Unstructured, error) {
+	t.Helper()
+
+	dspa := &unstructured.Unstructured{}
+	dspa.SetGroupVersionKind(dspaGroupVersionKind)
+	err := cli.Get(context.Background(), client.ObjectKey{Name: AutoProvisionedDSPAName, Namespace: namespace}, dspa)
+	return dspa, err
+}
+
+func TestReconcileAutoProvisionedDSPAs_NilSelectorIsRejected(t *testing.T) {
+	cli := fake.NewClientBuilder().WithScheme(newDSPATestScheme(t)).
+		WithObjects(testNamespace("team-a", nil), testNamespace("kube-system", nil)).
+		Build()
+
+	d := &DataSciencePipelines{AutoProvision: &AutoProvision{}}
+	dscispec := &dsciv1.DSCInitializationSpec{ApplicationsNamespace: "redhat-ods-applications"}
+	owner := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "owner-ns", UID: types.UID("owner-uid")}}
+
+	if err := d.reconcileAutoProvisionedDSPAs(context.Background(), cli, owner, dscispec); err == nil {
+		t.Fatal("expected an error when namespaceSelector is nil, got none")
+	}
+
+	for _, ns := range []string{"team-a", "kube-system"} {
+		if _, err := getDSPA(t, cli, ns); !k8serr.IsNotFound(err) {
+			t.Errorf("expected no DSPA in namespace %s when namespaceSelector is nil, got error: %v", ns, err)
+		}
+	}
+}
+
+func TestReconcileAutoProvisionedDSPAs_OnlyMatchingNamespacesGetADSPA(t *testing.T) {
+	cli := fake.NewClientBuilder().WithScheme(newDSPATestScheme(t)).
+		WithObjects(testNamespace("team-a", map[string]string{"provision": "true"}), testNamespace("team-b", nil)).
+		Build()
+
+	d := &DataSciencePipelines{
+		AutoProvision: &AutoProvision{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"provision": "true"}},
+		},
+	}
+	dscispec := &dsciv1.DSCInitializationSpec{ApplicationsNamespace: "redhat-ods-applications"}
+	owner := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "owner-ns", UID: types.UID("owner-uid")}}
+
+	if err := d.reconcileAutoProvisionedDSPAs(context.Background(), cli, owner, dscispec); err != nil {
+		t.Fatalf("reconcileAutoProvisionedDSPAs returned error: %v", err)
+	}
+
+	if _, err := getDSPA(t, cli, "team-a"); err != nil {
+		t.Errorf("expected a DSPA in namespace team-a, got error: %v", err)
+	}
+	if _, err := getDSPA(t, cli, "team-b"); !k8serr.IsNotFound(err) {
+		t.Errorf("expected no DSPA in namespace team-b (doesn't match namespaceSelector), got error: %v", err)
+	}
+}
+
+func TestReconcileAutoProvisionedDSPAs_RemovesStaleDSPAsOutsideSelector(t *testing.T) {
+	stale := &unstructured.Unstructured{}
+	stale.SetGroupVersionKind(dspaGroupVersionKind)
+	stale.SetName(AutoProvisionedDSPAName)
+	stale.SetNamespace("team-old")
+	stale.SetLabels(map[string]string{AutoProvisionedLabel: "true"})
+
+	cli := fake.NewClientBuilder().WithScheme(newDSPATestScheme(t)).
+		WithObjects(testNamespace("team-new", map[string]string{"provision": "true"}), testNamespace("team-old", nil)).
+		WithRuntimeObjects(stale).
+		Build()
+
+	d := &DataSciencePipelines{
+		AutoProvision: &AutoProvision{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"provision": "true"}},
+		},
+	}
+	dscispec := &dsciv1.DSCInitializationSpec{ApplicationsNamespace: "redhat-ods-applications"}
+	owner := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "owner-ns", UID: types.UID("owner-uid")}}
+
+	if err := d.reconcileAutoProvisionedDSPAs(context.Background(), cli, owner, dscispec); err != nil {
+		t.Fatalf("reconcileAutoProvisionedDSPAs returned error: %v", err)
+	}
+
+	if _, err := getDSPA(t, cli, "team-new"); err != nil {
+		t.Errorf("expected a DSPA in namespace team-new, got error: %v", err)
+	}
+	if _, err := getDSPA(t, cli, "team-old"); !k8serr.IsNotFound(err) {
+		t.Errorf("expected stale DSPA in team-old (no longer matching NamespaceSelector) to be removed, got error: %v", err)
+	}
+}
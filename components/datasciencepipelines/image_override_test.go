@@ -0,0 +1,76 @@
+package datasciencepipelines
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTestParamsEnv(t *testing.T, contents string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "params.env"), []byte(contents), 0o644); err != nil {
+		t.Fatalf("failed to write test params.env: %v", err)
+	}
+	return dir
+}
+
+func readTestParamsEnv(t *testing.T, componentPath string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join(componentPath, "params.env"))
+	if err != nil {
+		t.Fatalf("failed to read test params.env: %v", err)
+	}
+	return string(data)
+}
+
+func TestApplyImageOverride_LiteralImageWrittenDirectlyToParamsFile(t *testing.T) {
+	componentPath := writeTestParamsEnv(t, "IMAGES_ARGO_WORKFLOWCONTROLLER=quay.io/example/argo-workflowcontroller:v1\n")
+	literal := "quay.io/example/argo-workflowcontroller:v2"
+
+	if err := applyImageOverride(componentPath, "IMAGES_ARGO_WORKFLOWCONTROLLER", literal); err != nil {
+		t.Fatalf("applyImageOverride returned error: %v", err)
+	}
+
+	got := readTestParamsEnv(t, componentPath)
+	want := "IMAGES_ARGO_WORKFLOWCONTROLLER=" + literal + "\n"
+	if got != want {
+		t.Errorf("params.env = %q, want %q", got, want)
+	}
+
+	// A literal override must never be smuggled through process-global env.
+	if _, ok := os.LookupEnv("DEVFLAGS_IMAGE_OVERRIDE_IMAGES_ARGO_WORKFLOWCONTROLLER"); ok {
+		t.Error("applyImageOverride must not set a synthetic process env var for a literal override")
+	}
+}
+
+func TestApplyImageOverride_ExistingEnvVarNameResolvedNormally(t *testing.T) {
+	t.Setenv("MY_CUSTOM_IMAGE_VAR", "quay.io/example/argo-exec:v1")
+	componentPath := writeTestParamsEnv(t, "IMAGES_ARGO_EXEC=quay.io/example/argo-exec:v0\n")
+
+	if err := applyImageOverride(componentPath, "IMAGES_ARGO_EXEC", "MY_CUSTOM_IMAGE_VAR"); err != nil {
+		t.Fatalf("applyImageOverride returned error: %v", err)
+	}
+
+	got := readTestParamsEnv(t, componentPath)
+	want := "IMAGES_ARGO_EXEC=quay.io/example/argo-exec:v1\n"
+	if got != want {
+		t.Errorf("params.env = %q, want %q", got, want)
+	}
+}
+
+func TestApplyLiteralParam_AppendsMissingParam(t *testing.T) {
+	componentPath := writeTestParamsEnv(t, "IMAGES_DSPO=quay.io/example/dspo:v1\n")
+
+	if err := applyLiteralParam(componentPath, "IMAGES_ARGO_EXEC", "quay.io/example/argo-exec:v2"); err != nil {
+		t.Fatalf("applyLiteralParam returned error: %v", err)
+	}
+
+	got := readTestParamsEnv(t, componentPath)
+	want := "IMAGES_DSPO=quay.io/example/dspo:v1\nIMAGES_ARGO_EXEC=quay.io/example/argo-exec:v2\n"
+	if got != want {
+		t.Errorf("params.env = %q, want %q", got, want)
+	}
+}